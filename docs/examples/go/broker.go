@@ -0,0 +1,30 @@
+// Example showing how to trade through the broker-agnostic interface
+package main
+
+import (
+	"fmt"
+	"time"
+
+	"binaryoptions"
+)
+
+func main() {
+	broker, err := binaryoptions.NewBroker(binaryoptions.PocketOption, "your-session-id")
+	if err != nil {
+		panic(err)
+	}
+	defer broker.Close()
+	time.Sleep(5 * time.Second)
+
+	balance, err := broker.Balance()
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Current Balance: $%.2f\n", balance)
+
+	deal, err := broker.Buy("EURUSD_otc", 60, 1.0)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Trade placed with ID: %s\n", deal.ID)
+}
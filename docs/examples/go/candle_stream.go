@@ -0,0 +1,43 @@
+// Example showing how to stream candles with automatic reconnects
+package main
+
+import (
+	"fmt"
+	"time"
+
+	"binaryoptions"
+)
+
+func main() {
+	broker, err := binaryoptions.NewPocketOptionBroker(
+		"your-session-id",
+		binaryoptions.WithReconnect(binaryoptions.ReconnectPolicy{
+			InitialBackoff: time.Second,
+			MaxBackoff:     30 * time.Second,
+			Multiplier:     2,
+		}),
+	)
+	if err != nil {
+		panic(err)
+	}
+	defer broker.Close()
+
+	stream, err := broker.Subscribe("EURUSD_otc", 60)
+	if err != nil {
+		panic(err)
+	}
+	defer stream.Close()
+
+	fmt.Println("Listening for real-time candles...")
+	for {
+		select {
+		case c, ok := <-stream.C():
+			if !ok {
+				return
+			}
+			fmt.Printf("candle close: %.5f\n", c.Close)
+		case err := <-stream.Err():
+			fmt.Printf("stream error (reconnecting): %v\n", err)
+		}
+	}
+}
@@ -0,0 +1,28 @@
+// Example showing how to trade a practice/demo account
+package main
+
+import (
+	"fmt"
+	"time"
+
+	"binaryoptions"
+)
+
+func main() {
+	broker, err := binaryoptions.NewPocketOptionBroker("your-session-id", binaryoptions.WithDemo(true))
+	if err != nil {
+		panic(err)
+	}
+	defer broker.Close()
+	time.Sleep(5 * time.Second)
+
+	if broker.Mode() != binaryoptions.Demo {
+		panic("expected a demo account")
+	}
+
+	deal, err := broker.Buy("EURUSD_otc", 60, 1.0)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Placed demo trade %s (demo=%t)\n", deal.ID, deal.IsDemo)
+}
@@ -0,0 +1,33 @@
+// Example showing how to pull historical candles and compute indicators
+package main
+
+import (
+	"fmt"
+
+	"binaryoptions"
+	"indicators"
+)
+
+func main() {
+	broker, err := binaryoptions.NewPocketOptionBroker("your-session-id")
+	if err != nil {
+		panic(err)
+	}
+	defer broker.Close()
+
+	candles, err := broker.GetKlineRecords("EURUSD_otc", binaryoptions.Timeframe(60), 200)
+	if err != nil {
+		panic(err)
+	}
+
+	closes := make([]float64, len(candles))
+	for i, c := range candles {
+		closes[i] = c.Close
+	}
+
+	rsi := indicators.RSI(closes, 14)
+	fmt.Printf("Latest RSI(14): %.2f\n", rsi[len(rsi)-1])
+
+	runner := binaryoptions.NewRunner(broker, binaryoptions.Asset{Symbol: "EURUSD_otc", Timeframe: 60})
+	fmt.Printf("Store RSI(14) before any candle arrives: %.2f\n", runner.Store().RSI("EURUSD_otc", 60, 14))
+}
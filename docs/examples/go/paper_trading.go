@@ -0,0 +1,39 @@
+// Example showing how to rehearse a strategy with the paper broker
+package main
+
+import (
+	"fmt"
+	"time"
+
+	"binaryoptions"
+)
+
+func main() {
+	live, err := binaryoptions.NewPocketOptionBroker("your-session-id")
+	if err != nil {
+		panic(err)
+	}
+	defer live.Close()
+
+	paper := binaryoptions.NewPaperBroker(live,
+		binaryoptions.WithInitialBalance(1000),
+		binaryoptions.WithPayout(0.85),
+		binaryoptions.WithAssetFee("EURUSD_otc", 0.01),
+	)
+	defer paper.Close()
+
+	deal, err := paper.Buy("EURUSD_otc", 60, 10.0)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Opened paper deal %s, balance now $%.2f\n", deal.ID, paper.Get())
+
+	time.Sleep(65 * time.Second)
+
+	result, err := paper.CheckWin(deal.ID)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Result: win=%t profit=$%.2f balance=$%.2f fees=$%.2f\n",
+		result.Win, result.Profit, paper.Get(), paper.GetFeeTotal())
+}
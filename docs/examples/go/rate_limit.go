@@ -0,0 +1,35 @@
+// Example showing how to cap order/subscribe throughput on the broker
+package main
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"binaryoptions"
+)
+
+func main() {
+	broker, err := binaryoptions.NewPocketOptionBroker(
+		"your-session-id",
+		binaryoptions.WithOrderRate(1, 3),
+		binaryoptions.WithDataRate(5, 10),
+	)
+	if err != nil {
+		panic(err)
+	}
+	defer broker.Close()
+	time.Sleep(5 * time.Second)
+
+	for i := 0; i < 5; i++ {
+		_, err := broker.Buy("EURUSD_otc", 60, 1.0)
+		if errors.Is(err, binaryoptions.ErrRateLimited) {
+			fmt.Println("order rate limited, backing off")
+			time.Sleep(time.Second)
+			continue
+		}
+		if err != nil {
+			panic(err)
+		}
+	}
+}
@@ -0,0 +1,42 @@
+// Example showing how to run a simple strategy against live candles
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"binaryoptions"
+)
+
+type printStrategy struct{}
+
+func (printStrategy) OnStart(ctx context.Context, broker binaryoptions.Broker) error {
+	fmt.Println("strategy started")
+	return nil
+}
+
+func (printStrategy) OnCandle(c binaryoptions.Candle) {
+	fmt.Printf("candle close: %.5f\n", c.Close)
+}
+
+func (printStrategy) OnTrade(d binaryoptions.Deal)         {}
+func (printStrategy) OnTradeResult(r binaryoptions.Result) {}
+
+func (printStrategy) OnBalance(balance float64) {
+	fmt.Printf("balance: $%.2f\n", balance)
+}
+
+func main() {
+	broker, err := binaryoptions.NewBroker(binaryoptions.PocketOption, "your-session-id")
+	if err != nil {
+		panic(err)
+	}
+	defer broker.Close()
+
+	runner := binaryoptions.NewRunner(broker, binaryoptions.Asset{Symbol: "EURUSD_otc", Timeframe: 60})
+	runner.Register(printStrategy{})
+
+	if err := runner.Run(context.Background()); err != nil {
+		panic(err)
+	}
+}
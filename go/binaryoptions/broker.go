@@ -0,0 +1,125 @@
+// Package binaryoptions provides a broker-agnostic layer on top of the
+// generated per-platform clients (binary_options_tools_uni and friends).
+// Strategies are written once against the Broker interface and can be
+// pointed at any concrete implementation without modification.
+package binaryoptions
+
+import (
+	"fmt"
+	"time"
+)
+
+// Deal represents a single binary option contract opened with a broker.
+type Deal struct {
+	ID       string
+	Asset    string
+	Amount   float64
+	Duration int
+	OpenedAt time.Time
+	IsDemo   bool
+}
+
+// Mode distinguishes a broker's live account from its practice/demo account.
+type Mode int
+
+const (
+	// Live trades real funds.
+	Live Mode = iota
+	// Demo trades against a practice account; no real funds move.
+	Demo
+)
+
+func (m Mode) String() string {
+	if m == Demo {
+		return "demo"
+	}
+	return "live"
+}
+
+// Result is the outcome of a previously opened Deal.
+type Result struct {
+	ID     string
+	Win    bool
+	Profit float64
+}
+
+// Candle is a single OHLC bar for an asset/timeframe pair.
+type Candle struct {
+	Symbol string
+	Time   time.Time
+	Open   float64
+	High   float64
+	Low    float64
+	Close  float64
+	Volume float64
+}
+
+// Subscription is returned by Broker.Subscribe and stays open until Close is
+// called. Multiple Subscriptions for the same (symbol, timeframe) pair may
+// share a single underlying connection.
+type Subscription interface {
+	// C streams candles as they arrive until the subscription is closed.
+	C() <-chan Candle
+	// Err streams transport errors (e.g. a dropped connection while a
+	// reconnect is in progress) without closing C.
+	Err() <-chan error
+	Close() error
+}
+
+// Broker is the common contract implemented by every supported trading
+// platform. Writing a strategy against this interface instead of a concrete
+// client keeps it portable across brokers.
+type Broker interface {
+	// Balance returns the current account balance.
+	Balance() (float64, error)
+
+	// Buy opens a long ("call") binary option on asset for the given
+	// duration in seconds and stake amount.
+	Buy(asset string, duration int, amount float64) (Deal, error)
+
+	// Sell opens a short ("put") binary option on asset for the given
+	// duration in seconds and stake amount.
+	Sell(asset string, duration int, amount float64) (Deal, error)
+
+	// Subscribe streams candles for asset at the given timeframe in seconds.
+	Subscribe(asset string, timeframe int) (Subscription, error)
+
+	// CheckWin reports the outcome of a previously opened deal.
+	CheckWin(dealID string) (Result, error)
+
+	// History returns the most recent deals for asset, newest first.
+	History(asset string, limit int) ([]Deal, error)
+
+	// Assets lists the tradable asset symbols currently offered.
+	Assets() ([]string, error)
+
+	// Mode reports whether the broker is trading a live or demo account, so
+	// strategies can refuse to trade on the wrong one.
+	Mode() Mode
+
+	// Close releases any connection held by the broker.
+	Close() error
+}
+
+// Name identifies a supported trading platform for use with NewBroker.
+type Name string
+
+const (
+	// PocketOption selects the PocketOption broker.
+	PocketOption Name = "pocketoption"
+	// Quotex selects the Quotex broker.
+	Quotex Name = "quotex"
+)
+
+// NewBroker connects to the named platform using sessionID and returns it as
+// a Broker, so callers don't need to depend on the concrete client type.
+func NewBroker(name Name, sessionID string, opts ...Option) (Broker, error) {
+	switch name {
+	case PocketOption:
+		return NewPocketOptionBroker(sessionID, opts...)
+	case Quotex:
+		return NewQuotexBroker(sessionID, opts...)
+	default:
+		return nil, fmt.Errorf("binaryoptions: unknown broker %q", name)
+	}
+}
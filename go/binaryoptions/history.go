@@ -0,0 +1,75 @@
+package binaryoptions
+
+import (
+	"context"
+	"time"
+)
+
+// Timeframe is a candle aggregation period expressed in seconds.
+type Timeframe int
+
+// HistoryOption configures a single GetKlineRecords call.
+type HistoryOption func(*historyOptions)
+
+type historyOptions struct {
+	since time.Time
+}
+
+// Since restricts a history query to records at or after t.
+func Since(t time.Time) HistoryOption {
+	return func(o *historyOptions) { o.since = t }
+}
+
+// GetKlineRecords returns up to size historical candles for symbol at the
+// given period, oldest first.
+func (p *PocketOptionBroker) GetKlineRecords(symbol string, period Timeframe, size int, opts ...HistoryOption) ([]Candle, error) {
+	if err := p.opts.allowData(context.Background()); err != nil {
+		return nil, err
+	}
+
+	o := &historyOptions{}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	records, err := p.client.GetKlineRecords(symbol, int(period), size)
+	if err != nil {
+		return nil, err
+	}
+
+	candles := make([]Candle, len(records))
+	for i, r := range records {
+		candles[i] = Candle{Symbol: symbol, Time: r.Time, Open: r.Open, High: r.High, Low: r.Low, Close: r.Close, Volume: r.Volume}
+	}
+	if !o.since.IsZero() {
+		candles = sinceCandles(candles, o.since)
+	}
+	return candles, nil
+}
+
+// GetHistory returns deals placed on symbol between from and to.
+func (p *PocketOptionBroker) GetHistory(symbol string, from, to time.Time) ([]Deal, error) {
+	if err := p.opts.allowData(context.Background()); err != nil {
+		return nil, err
+	}
+
+	records, err := p.client.GetHistory(symbol, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	deals := make([]Deal, len(records))
+	for i, r := range records {
+		deals[i] = Deal{ID: r.ID, Asset: symbol, Amount: r.Amount, Duration: r.Duration, OpenedAt: r.Time, IsDemo: p.opts.demo}
+	}
+	return deals, nil
+}
+
+func sinceCandles(candles []Candle, since time.Time) []Candle {
+	for i, c := range candles {
+		if !c.Time.Before(since) {
+			return candles[i:]
+		}
+	}
+	return nil
+}
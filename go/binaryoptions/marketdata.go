@@ -0,0 +1,91 @@
+package binaryoptions
+
+import "sync"
+
+// candleRing is a fixed-size ring buffer of the most recent candles for one
+// (symbol, timeframe) pair.
+type candleRing struct {
+	mu      sync.RWMutex
+	candles []Candle
+	next    int
+	full    bool
+}
+
+func newCandleRing(size int) *candleRing {
+	return &candleRing{candles: make([]Candle, size)}
+}
+
+func (r *candleRing) push(c Candle) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.candles[r.next] = c
+	r.next = (r.next + 1) % len(r.candles)
+	if r.next == 0 {
+		r.full = true
+	}
+}
+
+// last returns up to n of the most recent candles, oldest first.
+func (r *candleRing) last(n int) []Candle {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	size := r.next
+	if r.full {
+		size = len(r.candles)
+	}
+	if n > size {
+		n = size
+	}
+	out := make([]Candle, n)
+	for i := 0; i < n; i++ {
+		idx := (r.next - n + i + len(r.candles)) % len(r.candles)
+		out[i] = r.candles[idx]
+	}
+	return out
+}
+
+type marketKey struct {
+	symbol    string
+	timeframe int
+}
+
+// MarketDataStore keeps a rolling window of the last N candles per
+// (symbol, timeframe) pair so strategies can look back without issuing
+// additional network calls.
+type MarketDataStore struct {
+	size int
+
+	mu    sync.Mutex
+	rings map[marketKey]*candleRing
+}
+
+// NewMarketDataStore returns a store that retains up to size candles per
+// (symbol, timeframe) pair.
+func NewMarketDataStore(size int) *MarketDataStore {
+	return &MarketDataStore{size: size, rings: make(map[marketKey]*candleRing)}
+}
+
+// Add records a newly observed candle for symbol/timeframe.
+func (s *MarketDataStore) Add(symbol string, timeframe int, c Candle) {
+	s.ringFor(symbol, timeframe).push(c)
+}
+
+// Candles returns up to n of the most recent candles for symbol/timeframe,
+// oldest first.
+func (s *MarketDataStore) Candles(symbol string, timeframe int, n int) []Candle {
+	return s.ringFor(symbol, timeframe).last(n)
+}
+
+func (s *MarketDataStore) ringFor(symbol string, timeframe int) *candleRing {
+	key := marketKey{symbol, timeframe}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	ring, ok := s.rings[key]
+	if !ok {
+		ring = newCandleRing(s.size)
+		s.rings[key] = ring
+	}
+	return ring
+}
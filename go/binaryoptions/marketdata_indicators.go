@@ -0,0 +1,58 @@
+package binaryoptions
+
+import "github.com/ChipaDevTeam/BinaryOptionsTools-v2/go/indicators"
+
+// The methods below recompute each indicator from scratch over the whole
+// buffered window on every call rather than updating incrementally as
+// candles arrive. That's deliberate for now: at the store's window sizes
+// (typically a couple hundred candles) a full recompute is cheap and keeps
+// SMA/EMA/RSI/BollingerBands/MACD trivially consistent with the indicators
+// package. If the window grows large enough for that recompute to matter,
+// these should switch to carrying the running state (last EMA, avg
+// gain/loss, etc.) that indicators.go's loops already compute internally.
+
+func (s *MarketDataStore) closes(symbol string, timeframe int) []float64 {
+	candles := s.Candles(symbol, timeframe, s.size)
+	closes := make([]float64, len(candles))
+	for i, c := range candles {
+		closes[i] = c.Close
+	}
+	return closes
+}
+
+func last(series []float64) float64 {
+	if len(series) == 0 {
+		return 0
+	}
+	return series[len(series)-1]
+}
+
+// SMA returns the current simple moving average for symbol/timeframe,
+// recomputed from the candles buffered so far.
+func (s *MarketDataStore) SMA(symbol string, timeframe int, period int) float64 {
+	return last(indicators.SMA(s.closes(symbol, timeframe), period))
+}
+
+// EMA returns the current exponential moving average for symbol/timeframe.
+func (s *MarketDataStore) EMA(symbol string, timeframe int, period int) float64 {
+	return last(indicators.EMA(s.closes(symbol, timeframe), period))
+}
+
+// RSI returns the current relative strength index for symbol/timeframe.
+func (s *MarketDataStore) RSI(symbol string, timeframe int, period int) float64 {
+	return last(indicators.RSI(s.closes(symbol, timeframe), period))
+}
+
+// BollingerBands returns the current upper, middle and lower bands for
+// symbol/timeframe.
+func (s *MarketDataStore) BollingerBands(symbol string, timeframe int, period int, numStdDev float64) (upper, middle, lower float64) {
+	u, m, l := indicators.BollingerBands(s.closes(symbol, timeframe), period, numStdDev)
+	return last(u), last(m), last(l)
+}
+
+// MACD returns the current MACD line, signal line and histogram for
+// symbol/timeframe.
+func (s *MarketDataStore) MACD(symbol string, timeframe int, fast, slow, signal int) (macd, signalLine, histogram float64) {
+	m, sig, hist := indicators.MACD(s.closes(symbol, timeframe), fast, slow, signal)
+	return last(m), last(sig), last(hist)
+}
@@ -0,0 +1,53 @@
+package binaryoptions
+
+import "testing"
+
+func TestCandleRingLastReturnsMostRecentOldestFirst(t *testing.T) {
+	r := newCandleRing(3)
+	for i := 0; i < 5; i++ {
+		r.push(Candle{Close: float64(i)})
+	}
+
+	got := r.last(3)
+	want := []float64{2, 3, 4}
+	if len(got) != len(want) {
+		t.Fatalf("last(3) len = %d, want %d", len(got), len(want))
+	}
+	for i, c := range got {
+		if c.Close != want[i] {
+			t.Fatalf("last(3)[%d].Close = %v, want %v", i, c.Close, want[i])
+		}
+	}
+}
+
+func TestCandleRingLastBeforeFull(t *testing.T) {
+	r := newCandleRing(5)
+	r.push(Candle{Close: 1})
+	r.push(Candle{Close: 2})
+
+	got := r.last(10)
+	if len(got) != 2 {
+		t.Fatalf("last(10) len = %d, want 2", len(got))
+	}
+	if got[0].Close != 1 || got[1].Close != 2 {
+		t.Fatalf("last(10) = %+v, want [1 2]", got)
+	}
+}
+
+func TestMarketDataStoreKeepsRollingWindowPerSymbolAndTimeframe(t *testing.T) {
+	s := NewMarketDataStore(2)
+	s.Add("EURUSD_otc", 60, Candle{Close: 1})
+	s.Add("EURUSD_otc", 60, Candle{Close: 2})
+	s.Add("EURUSD_otc", 60, Candle{Close: 3})
+	s.Add("EURUSD_otc", 300, Candle{Close: 100})
+
+	got := s.Candles("EURUSD_otc", 60, 10)
+	if len(got) != 2 || got[0].Close != 2 || got[1].Close != 3 {
+		t.Fatalf("Candles(60) = %+v, want [2 3]", got)
+	}
+
+	other := s.Candles("EURUSD_otc", 300, 10)
+	if len(other) != 1 || other[0].Close != 100 {
+		t.Fatalf("Candles(300) = %+v, want [100]", other)
+	}
+}
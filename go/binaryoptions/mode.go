@@ -0,0 +1,21 @@
+package binaryoptions
+
+// WithDemo routes the broker's connection to the platform's practice/demo
+// endpoint instead of the live one. Every Deal placed while demo mode is
+// active has IsDemo set, and Broker.Mode reports Demo.
+func WithDemo(demo bool) Option {
+	return func(o *options) { o.demo = demo }
+}
+
+// WithEndpoint overrides the base URL the broker connects to, e.g. to route
+// through a self-hosted proxy.
+func WithEndpoint(url string) Option {
+	return func(o *options) { o.endpoint = url }
+}
+
+func (o *options) mode() Mode {
+	if o.demo {
+		return Demo
+	}
+	return Live
+}
@@ -0,0 +1,339 @@
+package binaryoptions
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+)
+
+// PaperOption configures a PaperBroker returned by NewPaperBroker.
+type PaperOption func(*paperConfig)
+
+type paperConfig struct {
+	initialBalance float64
+	payout         float64
+	fees           map[string]float64
+}
+
+func newPaperConfig(opts ...PaperOption) *paperConfig {
+	c := &paperConfig{initialBalance: 10000, payout: 0.85, fees: make(map[string]float64)}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// WithInitialBalance sets the paper account's starting cash balance.
+// Defaults to 10000.
+func WithInitialBalance(amount float64) PaperOption {
+	return func(c *paperConfig) { c.initialBalance = amount }
+}
+
+// WithPayout sets the fraction of a winning stake paid out, e.g. 0.85 for an
+// 85% payout. Defaults to 0.85.
+func WithPayout(pct float64) PaperOption {
+	return func(c *paperConfig) { c.payout = pct }
+}
+
+// WithAssetFee charges fee on every trade opened on asset.
+func WithAssetFee(asset string, fee float64) PaperOption {
+	return func(c *paperConfig) { c.fees[asset] = fee }
+}
+
+type direction int
+
+const (
+	directionLong direction = iota
+	directionShort
+)
+
+type paperDeal struct {
+	Deal
+	direction direction
+	settleAt  time.Time
+	openPx    float64
+	settled   bool
+	result    Result
+}
+
+// pricingTimeframe is the candle granularity the paper broker subscribes at
+// to track an asset's last price. It is independent of any deal's
+// duration: a 1-hour option still needs frequent price ticks to mark to
+// market, so this stays fixed rather than following the option's expiry.
+const pricingTimeframe = 5
+
+// priceWaitTimeout bounds how long priceFor waits for the first candle on a
+// freshly opened pricing subscription.
+const priceWaitTimeout = 15 * time.Second
+
+// subAttempt tracks a single in-flight (or completed) pricing subscription
+// attempt for one asset, so concurrent first-time priceFor calls share one
+// Subscribe call instead of racing to open one each.
+type subAttempt struct {
+	once sync.Once
+	err  error
+}
+
+// PaperBroker is an in-process Broker that never touches the network. It
+// prices off candles streamed from an underlying Broker (or fed in through
+// Replay), then simulates fills, payouts and win/loss determination
+// locally, so strategies can be rehearsed before risking real funds.
+type PaperBroker struct {
+	pricer Broker
+	cfg    *paperConfig
+
+	mu          sync.Mutex
+	balance     *vbalance
+	lastPx      map[string]float64
+	subs        map[string]Subscription
+	subAttempts map[string]*subAttempt
+	deals       map[string]*paperDeal
+	nextID      int
+}
+
+var _ Broker = (*PaperBroker)(nil)
+
+// NewPaperBroker returns a paper-trading Broker that sources prices from
+// pricer. pricer is typically a real Broker (e.g. PocketOptionBroker); it is
+// only ever read from, never traded on.
+func NewPaperBroker(pricer Broker, opts ...PaperOption) *PaperBroker {
+	cfg := newPaperConfig(opts...)
+	return &PaperBroker{
+		pricer:      pricer,
+		cfg:         cfg,
+		balance:     newVBalance(cfg.initialBalance),
+		lastPx:      make(map[string]float64),
+		subs:        make(map[string]Subscription),
+		subAttempts: make(map[string]*subAttempt),
+		deals:       make(map[string]*paperDeal),
+	}
+}
+
+// Get returns the current cash balance.
+func (p *PaperBroker) Get() float64 { return p.balance.Get() }
+
+// Pos returns the current net open position (positive long, negative short).
+func (p *PaperBroker) Pos() float64 { return p.balance.Pos() }
+
+// AvgOpenPrice returns the size-weighted average open price of the current
+// position.
+func (p *PaperBroker) AvgOpenPrice() float64 { return p.balance.AvgOpenPrice() }
+
+// GetFeeTotal returns the cumulative fees paid so far.
+func (p *PaperBroker) GetFeeTotal() float64 { return p.balance.GetFeeTotal() }
+
+func (p *PaperBroker) Balance() (float64, error) {
+	return p.Get(), nil
+}
+
+func (p *PaperBroker) Buy(asset string, duration int, amount float64) (Deal, error) {
+	return p.open(asset, duration, amount, directionLong)
+}
+
+func (p *PaperBroker) Sell(asset string, duration int, amount float64) (Deal, error) {
+	return p.open(asset, duration, amount, directionShort)
+}
+
+func (p *PaperBroker) open(asset string, duration int, amount float64, dir direction) (Deal, error) {
+	openPx, err := p.priceFor(asset)
+	if err != nil {
+		return Deal{}, err
+	}
+
+	fee := p.cfg.fees[asset]
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if dir == directionLong {
+		p.balance.openLong(amount, fee, openPx)
+	} else {
+		p.balance.openShort(amount, fee, openPx)
+	}
+
+	p.nextID++
+	id := fmt.Sprintf("paper-%d", p.nextID)
+	deal := Deal{ID: id, Asset: asset, Amount: amount, Duration: duration, OpenedAt: time.Now()}
+	p.deals[id] = &paperDeal{
+		Deal:      deal,
+		direction: dir,
+		settleAt:  deal.OpenedAt.Add(time.Duration(duration) * time.Second),
+		openPx:    openPx,
+	}
+	return deal, nil
+}
+
+func (p *PaperBroker) CheckWin(dealID string) (Result, error) {
+	p.mu.Lock()
+	d, ok := p.deals[dealID]
+	if ok && d.settled {
+		result := d.result
+		p.mu.Unlock()
+		return result, nil
+	}
+	p.mu.Unlock()
+	if !ok {
+		return Result{}, fmt.Errorf("binaryoptions: unknown paper deal %q", dealID)
+	}
+
+	if time.Now().Before(d.settleAt) {
+		return Result{}, fmt.Errorf("binaryoptions: paper deal %q has not expired yet", dealID)
+	}
+
+	closePx, err := p.priceFor(d.Asset)
+	if err != nil {
+		return Result{}, err
+	}
+
+	win := closePx > d.openPx
+	if d.direction == directionShort {
+		win = closePx < d.openPx
+	}
+
+	profit := -d.Amount
+	if win {
+		profit = d.Amount * p.cfg.payout
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if d.settled {
+		// Lost the race against a concurrent CheckWin call; return its result
+		// instead of crediting the balance a second time.
+		return d.result, nil
+	}
+	p.balance.settle(d.Amount+profit, d.direction, d.Amount, d.openPx)
+	d.settled = true
+	d.result = Result{ID: dealID, Win: win, Profit: profit}
+	return d.result, nil
+}
+
+func (p *PaperBroker) History(asset string, limit int) ([]Deal, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	var deals []Deal
+	for _, d := range p.deals {
+		if d.Asset == asset {
+			deals = append(deals, d.Deal)
+		}
+	}
+	sort.Slice(deals, func(i, j int) bool { return deals[i].OpenedAt.After(deals[j].OpenedAt) })
+	if limit > 0 && len(deals) > limit {
+		deals = deals[:limit]
+	}
+	return deals, nil
+}
+
+func (p *PaperBroker) Assets() ([]string, error) {
+	return p.pricer.Assets()
+}
+
+func (p *PaperBroker) Subscribe(asset string, timeframe int) (Subscription, error) {
+	return p.pricer.Subscribe(asset, timeframe)
+}
+
+func (p *PaperBroker) Mode() Mode {
+	return Demo
+}
+
+func (p *PaperBroker) Close() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for _, sub := range p.subs {
+		sub.Close()
+	}
+	return nil
+}
+
+// Replay drives the paper broker's pricing from candles instead of the
+// underlying pricer, so strategies can be backtested against historical
+// data. It blocks until candles is closed or ctx is cancelled.
+func (p *PaperBroker) Replay(ctx context.Context, candles <-chan Candle) error {
+	for {
+		select {
+		case c, ok := <-candles:
+			if !ok {
+				return nil
+			}
+			p.mu.Lock()
+			p.lastPx[c.Symbol] = c.Close
+			p.mu.Unlock()
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// priceFor returns the last known price for asset, subscribing to it at
+// pricingTimeframe through the pricer on first use. The subscription
+// granularity is fixed and deliberately independent of any deal's
+// duration, so a long-expiry option doesn't have to wait an entire
+// timeframe for its first mark-to-market price.
+//
+// The lookup-or-create of the subscription attempt and the attempt itself
+// are split across a sync.Once held under p.mu, so two concurrent first-time
+// callers for the same asset share one Subscribe call instead of each
+// opening (and one of them leaking) their own.
+func (p *PaperBroker) priceFor(asset string) (float64, error) {
+	p.mu.Lock()
+	if px, ok := p.lastPx[asset]; ok {
+		p.mu.Unlock()
+		return px, nil
+	}
+	attempt, ok := p.subAttempts[asset]
+	if !ok {
+		attempt = &subAttempt{}
+		p.subAttempts[asset] = attempt
+	}
+	p.mu.Unlock()
+
+	attempt.once.Do(func() {
+		sub, err := p.pricer.Subscribe(asset, pricingTimeframe)
+		if err != nil {
+			attempt.err = err
+			return
+		}
+
+		p.mu.Lock()
+		p.subs[asset] = sub
+		p.mu.Unlock()
+
+		go func() {
+			for c := range sub.C() {
+				p.mu.Lock()
+				p.lastPx[c.Symbol] = c.Close
+				p.mu.Unlock()
+			}
+		}()
+	})
+	if attempt.err != nil {
+		// Let a later call retry with a fresh attempt instead of failing
+		// forever on a transient subscribe error.
+		p.mu.Lock()
+		if p.subAttempts[asset] == attempt {
+			delete(p.subAttempts, asset)
+		}
+		p.mu.Unlock()
+		return 0, attempt.err
+	}
+
+	deadline := time.After(priceWaitTimeout)
+	ticker := time.NewTicker(50 * time.Millisecond)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			p.mu.Lock()
+			px, ok := p.lastPx[asset]
+			p.mu.Unlock()
+			if ok {
+				return px, nil
+			}
+		case <-deadline:
+			return 0, fmt.Errorf("binaryoptions: timed out waiting for a price on %q", asset)
+		}
+	}
+}
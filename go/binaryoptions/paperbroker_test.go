@@ -0,0 +1,185 @@
+package binaryoptions
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// fakePriceBroker is a minimal Broker that only serves fixed-price candle
+// subscriptions, so PaperBroker tests don't need a live connection.
+type fakePriceBroker struct {
+	price      float64
+	subscribes int32
+}
+
+var _ Broker = (*fakePriceBroker)(nil)
+
+func (f *fakePriceBroker) Balance() (float64, error)              { return 0, nil }
+func (f *fakePriceBroker) Buy(string, int, float64) (Deal, error)  { return Deal{}, nil }
+func (f *fakePriceBroker) Sell(string, int, float64) (Deal, error) { return Deal{}, nil }
+func (f *fakePriceBroker) CheckWin(string) (Result, error)         { return Result{}, nil }
+func (f *fakePriceBroker) History(string, int) ([]Deal, error)     { return nil, nil }
+func (f *fakePriceBroker) Assets() ([]string, error)               { return nil, nil }
+func (f *fakePriceBroker) Mode() Mode                               { return Live }
+func (f *fakePriceBroker) Close() error                             { return nil }
+
+func (f *fakePriceBroker) Subscribe(asset string, timeframe int) (Subscription, error) {
+	atomic.AddInt32(&f.subscribes, 1)
+	ch := make(chan Candle, 1)
+	ch <- Candle{Symbol: asset, Close: f.price}
+	close(ch)
+	return &fakeSubscription{ch: ch}, nil
+}
+
+type fakeSubscription struct {
+	ch chan Candle
+}
+
+func (s *fakeSubscription) C() <-chan Candle  { return s.ch }
+func (s *fakeSubscription) Err() <-chan error { return nil }
+func (s *fakeSubscription) Close() error      { return nil }
+
+func TestPaperBrokerCheckWinIsIdempotent(t *testing.T) {
+	pricer := &fakePriceBroker{price: 10}
+	paper := NewPaperBroker(pricer, WithInitialBalance(1000), WithPayout(0.8))
+
+	deal, err := paper.Buy("EURUSD_otc", 1, 100)
+	if err != nil {
+		t.Fatalf("Buy: %v", err)
+	}
+	if got := paper.Pos(); got != 100 {
+		t.Fatalf("Pos() after Buy = %v, want 100", got)
+	}
+
+	time.Sleep(1100 * time.Millisecond)
+	// The fake subscription delivers a single candle, so move the paper
+	// broker's last price directly: up since open, so the long side wins.
+	paper.mu.Lock()
+	paper.lastPx["EURUSD_otc"] = 20
+	paper.mu.Unlock()
+
+	first, err := paper.CheckWin(deal.ID)
+	if err != nil {
+		t.Fatalf("first CheckWin: %v", err)
+	}
+	if !first.Win {
+		t.Fatalf("expected a win, got %+v", first)
+	}
+	if got := paper.Pos(); got != 0 {
+		t.Fatalf("Pos() after settlement = %v, want 0", got)
+	}
+	if got := paper.AvgOpenPrice(); got != 0 {
+		t.Fatalf("AvgOpenPrice() after settlement = %v, want 0", got)
+	}
+	balanceAfterFirst := paper.Get()
+
+	second, err := paper.CheckWin(deal.ID)
+	if err != nil {
+		t.Fatalf("second CheckWin: %v", err)
+	}
+	if second != first {
+		t.Fatalf("second CheckWin returned %+v, want identical result %+v", second, first)
+	}
+	if got := paper.Get(); got != balanceAfterFirst {
+		t.Fatalf("balance changed on repeat CheckWin: got %v, want %v", got, balanceAfterFirst)
+	}
+	if got := paper.Pos(); got != 0 {
+		t.Fatalf("Pos() changed on repeat CheckWin: got %v, want 0", got)
+	}
+}
+
+func TestPaperBrokerCheckWinOnLoss(t *testing.T) {
+	pricer := &fakePriceBroker{price: 10}
+	paper := NewPaperBroker(pricer, WithInitialBalance(1000))
+
+	deal, err := paper.Buy("EURUSD_otc", 1, 100)
+	if err != nil {
+		t.Fatalf("Buy: %v", err)
+	}
+
+	time.Sleep(1100 * time.Millisecond)
+	pricer.price = 5 // price moved down, so the long side loses
+
+	result, err := paper.CheckWin(deal.ID)
+	if err != nil {
+		t.Fatalf("CheckWin: %v", err)
+	}
+	if result.Win {
+		t.Fatalf("expected a loss, got %+v", result)
+	}
+	if result.Profit != -100 {
+		t.Fatalf("Profit = %v, want -100", result.Profit)
+	}
+	if got, want := paper.Get(), 1000.0-100; got != want {
+		t.Fatalf("Get() = %v, want %v", got, want)
+	}
+}
+
+func TestPaperBrokerHistoryNewestFirst(t *testing.T) {
+	pricer := &fakePriceBroker{price: 10}
+	paper := NewPaperBroker(pricer)
+
+	now := time.Now()
+	for i := 0; i < 3; i++ {
+		d, err := paper.Buy("EURUSD_otc", 60, 1)
+		if err != nil {
+			t.Fatalf("Buy %d: %v", i, err)
+		}
+		paper.deals[d.ID].Deal.OpenedAt = now.Add(time.Duration(i) * time.Second)
+	}
+
+	deals, err := paper.History("EURUSD_otc", 0)
+	if err != nil {
+		t.Fatalf("History: %v", err)
+	}
+	if len(deals) != 3 {
+		t.Fatalf("len(deals) = %d, want 3", len(deals))
+	}
+	for i := 0; i < len(deals)-1; i++ {
+		if deals[i].OpenedAt.Before(deals[i+1].OpenedAt) {
+			t.Fatalf("History not sorted newest-first: %+v", deals)
+		}
+	}
+}
+
+func TestPaperBrokerPriceForSubscribesOnceUnderConcurrency(t *testing.T) {
+	pricer := &fakePriceBroker{price: 10}
+	paper := NewPaperBroker(pricer)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := paper.priceFor("EURUSD_otc"); err != nil {
+				t.Errorf("priceFor: %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if got := atomic.LoadInt32(&pricer.subscribes); got != 1 {
+		t.Fatalf("Subscribe called %d times, want 1", got)
+	}
+}
+
+func TestPaperBrokerHistoryRespectsLimit(t *testing.T) {
+	pricer := &fakePriceBroker{price: 10}
+	paper := NewPaperBroker(pricer)
+
+	for i := 0; i < 3; i++ {
+		if _, err := paper.Buy("EURUSD_otc", 60, 1); err != nil {
+			t.Fatalf("Buy %d: %v", i, err)
+		}
+	}
+
+	deals, err := paper.History("EURUSD_otc", 2)
+	if err != nil {
+		t.Fatalf("History: %v", err)
+	}
+	if len(deals) != 2 {
+		t.Fatalf("len(deals) = %d, want 2", len(deals))
+	}
+}
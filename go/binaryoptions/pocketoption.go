@@ -0,0 +1,246 @@
+package binaryoptions
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"binary_options_tools_uni"
+)
+
+type candleKey struct {
+	symbol    string
+	timeframe int
+}
+
+type orderBookKey struct {
+	symbol string
+	depth  int
+}
+
+// PocketOptionBroker adapts the generated binary_options_tools_uni client to
+// the Broker interface.
+type PocketOptionBroker struct {
+	client *binary_options_tools_uni.Client
+	opts   *options
+
+	mu            sync.Mutex
+	candleFeeds   map[candleKey]*feed[Candle]
+	tickFeeds     map[string]*feed[Tick]
+	orderBookFeed map[orderBookKey]*feed[OrderBook]
+	subscriptions []WireSubscription
+}
+
+var _ Broker = (*PocketOptionBroker)(nil)
+
+// NewPocketOptionBroker connects to PocketOption using sessionID and wraps
+// the resulting client as a Broker. By default order and data calls are
+// unlimited; pass WithOrderRate/WithDataRate to budget them.
+func NewPocketOptionBroker(sessionID string, opts ...Option) (*PocketOptionBroker, error) {
+	o := newOptions(opts...)
+
+	var rawOpts []binary_options_tools_uni.Option
+	if o.demo {
+		rawOpts = append(rawOpts, binary_options_tools_uni.WithDemo(true))
+	}
+	if o.endpoint != "" {
+		rawOpts = append(rawOpts, binary_options_tools_uni.WithEndpoint(o.endpoint))
+	}
+
+	client, err := binary_options_tools_uni.NewPocketOption(sessionID, rawOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("binaryoptions: connect to pocketoption: %w", err)
+	}
+	return &PocketOptionBroker{
+		client:        client,
+		opts:          o,
+		candleFeeds:   make(map[candleKey]*feed[Candle]),
+		tickFeeds:     make(map[string]*feed[Tick]),
+		orderBookFeed: make(map[orderBookKey]*feed[OrderBook]),
+	}, nil
+}
+
+func (p *PocketOptionBroker) Balance() (float64, error) {
+	if err := p.opts.allowData(context.Background()); err != nil {
+		return 0, err
+	}
+	return p.client.Balance()
+}
+
+func (p *PocketOptionBroker) Buy(asset string, duration int, amount float64) (Deal, error) {
+	if err := p.opts.allowOrder(context.Background()); err != nil {
+		return Deal{}, err
+	}
+	deal, err := p.client.Buy(asset, duration, amount)
+	if err != nil {
+		return Deal{}, err
+	}
+	return Deal{ID: deal.ID, Asset: asset, Amount: amount, Duration: duration, IsDemo: p.opts.demo}, nil
+}
+
+func (p *PocketOptionBroker) Sell(asset string, duration int, amount float64) (Deal, error) {
+	if err := p.opts.allowOrder(context.Background()); err != nil {
+		return Deal{}, err
+	}
+	deal, err := p.client.Sell(asset, duration, amount)
+	if err != nil {
+		return Deal{}, err
+	}
+	return Deal{ID: deal.ID, Asset: asset, Amount: amount, Duration: duration, IsDemo: p.opts.demo}, nil
+}
+
+func (p *PocketOptionBroker) Subscribe(asset string, timeframe int) (Subscription, error) {
+	if err := p.opts.allowData(context.Background()); err != nil {
+		return nil, err
+	}
+
+	key := candleKey{symbol: asset, timeframe: timeframe}
+	p.mu.Lock()
+	f, id, data, errs := acquireFeed(p.candleFeeds, key, func() *feed[Candle] {
+		return newFeed(p.dialCandles(asset, timeframe), p.opts.reconnect)
+	})
+	p.subscriptions = append(p.subscriptions, WireSubscription{Symbol: asset, Channel: "candle"})
+	p.mu.Unlock()
+
+	return &CandleStream{f: f, id: id, data: data, errs: errs}, nil
+}
+
+// SubscribeTicks streams every trade price update for asset, reconnecting
+// with the broker's configured ReconnectPolicy if the connection drops.
+func (p *PocketOptionBroker) SubscribeTicks(asset string) (*TickStream, error) {
+	if err := p.opts.allowData(context.Background()); err != nil {
+		return nil, err
+	}
+
+	p.mu.Lock()
+	f, id, data, errs := acquireFeed(p.tickFeeds, asset, func() *feed[Tick] {
+		return newFeed(p.dialTicks(asset), p.opts.reconnect)
+	})
+	p.subscriptions = append(p.subscriptions, WireSubscription{Symbol: asset, Channel: "tick"})
+	p.mu.Unlock()
+
+	return &TickStream{f: f, id: id, data: data, errs: errs}, nil
+}
+
+// SubscribeOrderBook streams depth snapshots of up to depth levels per side
+// for asset, reconnecting with the broker's configured ReconnectPolicy if
+// the connection drops.
+func (p *PocketOptionBroker) SubscribeOrderBook(asset string, depth int) (*OrderBookStream, error) {
+	if err := p.opts.allowData(context.Background()); err != nil {
+		return nil, err
+	}
+
+	key := orderBookKey{symbol: asset, depth: depth}
+	p.mu.Lock()
+	f, id, data, errs := acquireFeed(p.orderBookFeed, key, func() *feed[OrderBook] {
+		return newFeed(p.dialOrderBook(asset, depth), p.opts.reconnect)
+	})
+	p.subscriptions = append(p.subscriptions, WireSubscription{
+		Symbol:  asset,
+		Channel: "orderbook",
+		Options: map[string]string{"depth": fmt.Sprint(depth)},
+	})
+	p.mu.Unlock()
+
+	return &OrderBookStream{f: f, id: id, data: data, errs: errs}, nil
+}
+
+// Subscriptions lists every logical subscription opened on this broker so
+// far, live or not, for inspection and debugging.
+func (p *PocketOptionBroker) Subscriptions() []WireSubscription {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	out := make([]WireSubscription, len(p.subscriptions))
+	copy(out, p.subscriptions)
+	return out
+}
+
+func (p *PocketOptionBroker) dialCandles(symbol string, timeframe int) dialFunc[Candle] {
+	return func() (func() (Candle, error), func() error, error) {
+		raw, err := p.client.Subscribe(symbol, timeframe)
+		if err != nil {
+			return nil, nil, err
+		}
+		next := func() (Candle, error) {
+			c, err := raw.Next()
+			if err != nil {
+				return Candle{}, err
+			}
+			return Candle{Symbol: symbol, Time: c.Time, Open: c.Open, High: c.High, Low: c.Low, Close: c.Close, Volume: c.Volume}, nil
+		}
+		return next, raw.Close, nil
+	}
+}
+
+func (p *PocketOptionBroker) dialTicks(symbol string) dialFunc[Tick] {
+	return func() (func() (Tick, error), func() error, error) {
+		raw, err := p.client.SubscribeTicks(symbol)
+		if err != nil {
+			return nil, nil, err
+		}
+		next := func() (Tick, error) {
+			t, err := raw.Next()
+			if err != nil {
+				return Tick{}, err
+			}
+			return Tick{Symbol: symbol, Time: t.Time, Price: t.Price}, nil
+		}
+		return next, raw.Close, nil
+	}
+}
+
+func (p *PocketOptionBroker) dialOrderBook(symbol string, depth int) dialFunc[OrderBook] {
+	return func() (func() (OrderBook, error), func() error, error) {
+		raw, err := p.client.SubscribeOrderBook(symbol, depth)
+		if err != nil {
+			return nil, nil, err
+		}
+		next := func() (OrderBook, error) {
+			b, err := raw.Next()
+			if err != nil {
+				return OrderBook{}, err
+			}
+			ob := OrderBook{Symbol: symbol, Time: b.Time}
+			for _, lvl := range b.Bids {
+				ob.Bids = append(ob.Bids, OrderBookLevel{Price: lvl.Price, Size: lvl.Size})
+			}
+			for _, lvl := range b.Asks {
+				ob.Asks = append(ob.Asks, OrderBookLevel{Price: lvl.Price, Size: lvl.Size})
+			}
+			return ob, nil
+		}
+		return next, raw.Close, nil
+	}
+}
+
+func (p *PocketOptionBroker) CheckWin(dealID string) (Result, error) {
+	r, err := p.client.CheckWin(dealID)
+	if err != nil {
+		return Result{}, err
+	}
+	return Result{ID: dealID, Win: r.Win, Profit: r.Profit}, nil
+}
+
+func (p *PocketOptionBroker) History(asset string, limit int) ([]Deal, error) {
+	records, err := p.client.History(asset, limit)
+	if err != nil {
+		return nil, err
+	}
+	deals := make([]Deal, len(records))
+	for i, r := range records {
+		deals[i] = Deal{ID: r.ID, Asset: asset, Amount: r.Amount, Duration: r.Duration, IsDemo: p.opts.demo}
+	}
+	return deals, nil
+}
+
+func (p *PocketOptionBroker) Assets() ([]string, error) {
+	return p.client.Assets()
+}
+
+func (p *PocketOptionBroker) Mode() Mode {
+	return p.opts.mode()
+}
+
+func (p *PocketOptionBroker) Close() error {
+	return p.client.Close()
+}
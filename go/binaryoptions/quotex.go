@@ -0,0 +1,61 @@
+package binaryoptions
+
+import "errors"
+
+// ErrNotImplemented is returned by broker methods that are not yet wired up
+// to a real backend.
+var ErrNotImplemented = errors.New("binaryoptions: not implemented")
+
+// QuotexBroker is a placeholder Broker implementation for Quotex. It exists
+// so strategies can be written against the Quotex platform today; the
+// underlying client wiring lands in a follow-up once a generated Quotex
+// binding is available.
+type QuotexBroker struct {
+	sessionID string
+	opts      *options
+}
+
+var _ Broker = (*QuotexBroker)(nil)
+
+// NewQuotexBroker returns a Quotex broker stub bound to sessionID. Most
+// options are accepted for signature parity with NewPocketOptionBroker but
+// have no effect until a real client backs this implementation.
+func NewQuotexBroker(sessionID string, opts ...Option) (*QuotexBroker, error) {
+	return &QuotexBroker{sessionID: sessionID, opts: newOptions(opts...)}, nil
+}
+
+func (q *QuotexBroker) Balance() (float64, error) {
+	return 0, ErrNotImplemented
+}
+
+func (q *QuotexBroker) Buy(asset string, duration int, amount float64) (Deal, error) {
+	return Deal{}, ErrNotImplemented
+}
+
+func (q *QuotexBroker) Sell(asset string, duration int, amount float64) (Deal, error) {
+	return Deal{}, ErrNotImplemented
+}
+
+func (q *QuotexBroker) Subscribe(asset string, timeframe int) (Subscription, error) {
+	return nil, ErrNotImplemented
+}
+
+func (q *QuotexBroker) CheckWin(dealID string) (Result, error) {
+	return Result{}, ErrNotImplemented
+}
+
+func (q *QuotexBroker) History(asset string, limit int) ([]Deal, error) {
+	return nil, ErrNotImplemented
+}
+
+func (q *QuotexBroker) Assets() ([]string, error) {
+	return nil, ErrNotImplemented
+}
+
+func (q *QuotexBroker) Mode() Mode {
+	return q.opts.mode()
+}
+
+func (q *QuotexBroker) Close() error {
+	return nil
+}
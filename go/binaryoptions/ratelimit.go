@@ -0,0 +1,76 @@
+package binaryoptions
+
+import (
+	"context"
+	"errors"
+
+	"golang.org/x/time/rate"
+)
+
+// ErrRateLimited is returned when a call would exceed the configured rate
+// limit and blocking mode is disabled.
+var ErrRateLimited = errors.New("binaryoptions: rate limited")
+
+// Option configures a broker constructed by NewBroker or a platform-specific
+// constructor such as NewPocketOptionBroker.
+type Option func(*options)
+
+type options struct {
+	orderLimiter *rate.Limiter
+	dataLimiter  *rate.Limiter
+	blocking     bool
+	demo         bool
+	endpoint     string
+	reconnect    ReconnectPolicy
+}
+
+func newOptions(opts ...Option) *options {
+	o := &options{
+		orderLimiter: rate.NewLimiter(rate.Inf, 0),
+		dataLimiter:  rate.NewLimiter(rate.Inf, 0),
+		reconnect:    DefaultReconnectPolicy,
+	}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return o
+}
+
+// WithOrderRate limits Buy/Sell calls to r events per second with the given
+// burst, guarding against a broker banning or disconnecting an account that
+// trades too aggressively.
+func WithOrderRate(r float64, burst int) Option {
+	return func(o *options) { o.orderLimiter = rate.NewLimiter(rate.Limit(r), burst) }
+}
+
+// WithDataRate limits Balance/Subscribe calls to r events per second with
+// the given burst.
+func WithDataRate(r float64, burst int) Option {
+	return func(o *options) { o.dataLimiter = rate.NewLimiter(rate.Limit(r), burst) }
+}
+
+// WithBlocking makes rate-limited calls wait for the limiter to allow them
+// instead of returning ErrRateLimited immediately.
+func WithBlocking(blocking bool) Option {
+	return func(o *options) { o.blocking = blocking }
+}
+
+// allow blocks on l if blocking mode is enabled, otherwise it returns
+// ErrRateLimited when l would not currently allow an event through.
+func (o *options) allow(ctx context.Context, l *rate.Limiter) error {
+	if o.blocking {
+		return l.Wait(ctx)
+	}
+	if !l.Allow() {
+		return ErrRateLimited
+	}
+	return nil
+}
+
+func (o *options) allowOrder(ctx context.Context) error {
+	return o.allow(ctx, o.orderLimiter)
+}
+
+func (o *options) allowData(ctx context.Context) error {
+	return o.allow(ctx, o.dataLimiter)
+}
@@ -0,0 +1,204 @@
+package binaryoptions
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Strategy receives lifecycle callbacks from a Runner as market and account
+// events arrive. Implementations should return quickly; long-running work
+// belongs in a separate goroutine started from OnStart.
+type Strategy interface {
+	// OnStart is called once, before the Runner begins dispatching events.
+	// The broker passed in reports trades placed through it back to every
+	// registered Strategy as OnTrade/OnTradeResult, so a Strategy should
+	// trade through it rather than through a Broker obtained elsewhere.
+	OnStart(ctx context.Context, broker Broker) error
+
+	// OnCandle is called for every new candle on a subscribed asset.
+	OnCandle(c Candle)
+
+	// OnTrade is called whenever a trade is opened through the Broker
+	// passed to OnStart.
+	OnTrade(d Deal)
+
+	// OnTradeResult is called once a previously opened trade settles.
+	OnTradeResult(r Result)
+
+	// OnBalance is called whenever the account balance changes.
+	OnBalance(balance float64)
+}
+
+// Asset is an (symbol, timeframe) pair the Runner subscribes to on behalf of
+// its registered strategies.
+type Asset struct {
+	Symbol    string
+	Timeframe int
+}
+
+// Runner owns a Broker, subscribes to the configured assets, and dispatches
+// incoming candles to every registered Strategy. It also feeds a
+// MarketDataStore so strategies can look back over recent candles without
+// issuing additional network calls.
+type Runner struct {
+	broker     Broker
+	assets     []Asset
+	store      *MarketDataStore
+	strategies []Strategy
+}
+
+// NewRunner returns a Runner that subscribes to assets through broker and
+// keeps the last 200 candles per asset in its MarketDataStore.
+func NewRunner(broker Broker, assets ...Asset) *Runner {
+	return &Runner{broker: broker, assets: assets, store: NewMarketDataStore(200)}
+}
+
+// Register adds a Strategy that will receive events once Run starts.
+func (r *Runner) Register(s Strategy) {
+	r.strategies = append(r.strategies, s)
+}
+
+// Store returns the MarketDataStore the Runner feeds as candles arrive.
+func (r *Runner) Store() *MarketDataStore {
+	return r.store
+}
+
+// tradeResultPollInterval is how often dispatchTrade polls CheckWin for a
+// just-opened deal before its result is known.
+const tradeResultPollInterval = time.Second
+
+// balancePollInterval is how often Run polls the broker's balance to detect
+// changes worth an OnBalance dispatch.
+const balancePollInterval = 5 * time.Second
+
+// runnerBroker wraps the Runner's broker so trades a Strategy places through
+// it are reported back to every registered Strategy as OnTrade/OnTradeResult.
+type runnerBroker struct {
+	Broker
+	r  *Runner
+	wg *sync.WaitGroup
+}
+
+func (rb *runnerBroker) Buy(asset string, duration int, amount float64) (Deal, error) {
+	d, err := rb.Broker.Buy(asset, duration, amount)
+	if err == nil {
+		rb.r.dispatchTrade(d, rb.wg)
+	}
+	return d, err
+}
+
+func (rb *runnerBroker) Sell(asset string, duration int, amount float64) (Deal, error) {
+	d, err := rb.Broker.Sell(asset, duration, amount)
+	if err == nil {
+		rb.r.dispatchTrade(d, rb.wg)
+	}
+	return d, err
+}
+
+// dispatchTrade notifies every strategy that d was opened, then polls
+// CheckWin until d settles and notifies them of the result.
+func (r *Runner) dispatchTrade(d Deal, wg *sync.WaitGroup) {
+	for _, s := range r.strategies {
+		s.OnTrade(d)
+	}
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		ticker := time.NewTicker(tradeResultPollInterval)
+		defer ticker.Stop()
+		for range ticker.C {
+			result, err := r.broker.CheckWin(d.ID)
+			if err != nil {
+				continue
+			}
+			for _, s := range r.strategies {
+				s.OnTradeResult(result)
+			}
+			return
+		}
+	}()
+}
+
+// watchBalance polls the broker's balance and notifies every strategy
+// through OnBalance whenever it changes, until ctx is cancelled.
+func (r *Runner) watchBalance(ctx context.Context) {
+	ticker := time.NewTicker(balancePollInterval)
+	defer ticker.Stop()
+
+	var last float64
+	var haveLast bool
+	for {
+		select {
+		case <-ticker.C:
+			bal, err := r.broker.Balance()
+			if err != nil {
+				continue
+			}
+			if !haveLast || bal != last {
+				last, haveLast = bal, true
+				for _, s := range r.strategies {
+					s.OnBalance(bal)
+				}
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// Run subscribes to every configured asset and dispatches events to
+// registered strategies until ctx is cancelled or an error occurs.
+func (r *Runner) Run(ctx context.Context) error {
+	var wg sync.WaitGroup
+
+	broker := &runnerBroker{Broker: r.broker, r: r, wg: &wg}
+	for _, s := range r.strategies {
+		if err := s.OnStart(ctx, broker); err != nil {
+			return err
+		}
+	}
+
+	var opened []Subscription
+	for _, asset := range r.assets {
+		sub, err := r.broker.Subscribe(asset.Symbol, asset.Timeframe)
+		if err != nil {
+			for _, o := range opened {
+				o.Close()
+			}
+			wg.Wait()
+			return err
+		}
+		opened = append(opened, sub)
+
+		wg.Add(1)
+		go func(asset Asset, sub Subscription) {
+			defer wg.Done()
+			defer sub.Close()
+			for {
+				select {
+				case c, ok := <-sub.C():
+					if !ok {
+						return
+					}
+					r.store.Add(asset.Symbol, asset.Timeframe, c)
+					for _, s := range r.strategies {
+						s.OnCandle(c)
+					}
+				case <-ctx.Done():
+					return
+				}
+			}
+		}(asset, sub)
+	}
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		r.watchBalance(ctx)
+	}()
+
+	wg.Wait()
+	return ctx.Err()
+}
@@ -0,0 +1,285 @@
+package binaryoptions
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// ReconnectPolicy controls the exponential backoff used to resubscribe a
+// stream after its underlying connection drops.
+type ReconnectPolicy struct {
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+	Multiplier     float64
+}
+
+// DefaultReconnectPolicy backs off from 1s up to 30s, doubling on every
+// failed attempt.
+var DefaultReconnectPolicy = ReconnectPolicy{
+	InitialBackoff: time.Second,
+	MaxBackoff:     30 * time.Second,
+	Multiplier:     2,
+}
+
+// WithReconnect overrides the reconnect backoff policy used by streams
+// created on this broker. Defaults to DefaultReconnectPolicy.
+func WithReconnect(policy ReconnectPolicy) Option {
+	return func(o *options) { o.reconnect = policy }
+}
+
+func nextBackoff(cur time.Duration, p ReconnectPolicy) time.Duration {
+	next := time.Duration(float64(cur) * p.Multiplier)
+	if next > p.MaxBackoff {
+		next = p.MaxBackoff
+	}
+	return next
+}
+
+// sleepCtx waits for d or ctx cancellation, whichever comes first. It
+// reports whether the wait completed normally (false means ctx was done).
+func sleepCtx(ctx context.Context, d time.Duration) bool {
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-t.C:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// WireSubscription is a serializable record of one logical subscription
+// (symbol + channel + any extra options). The broker records one per
+// Subscribe call and keeps it after the stream is closed, so the
+// subscriptions it has opened can be inspected or logged.
+type WireSubscription struct {
+	Symbol  string
+	Channel string
+	Options map[string]string
+}
+
+func (s WireSubscription) String() string {
+	return fmt.Sprintf("%s.%s", s.Symbol, s.Channel)
+}
+
+// hub fans a single upstream value of type T out to any number of
+// subscriber channels. A slow subscriber drops values rather than blocking
+// the upstream feed, giving every stream type built on hub backpressure
+// that degrades to "skip a tick" instead of "stall everyone".
+type hub[T any] struct {
+	mu   sync.Mutex
+	subs map[int]chan T
+	errs map[int]chan error
+	next int
+}
+
+func newHub[T any]() *hub[T] {
+	return &hub[T]{subs: make(map[int]chan T), errs: make(map[int]chan error)}
+}
+
+func (h *hub[T]) subscribe() (id int, data chan T, errs chan error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	id = h.next
+	h.next++
+	data = make(chan T, 16)
+	errs = make(chan error, 1)
+	h.subs[id] = data
+	h.errs[id] = errs
+	return id, data, errs
+}
+
+func (h *hub[T]) unsubscribe(id int) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if ch, ok := h.subs[id]; ok {
+		close(ch)
+		delete(h.subs, id)
+	}
+	if ch, ok := h.errs[id]; ok {
+		close(ch)
+		delete(h.errs, id)
+	}
+}
+
+func (h *hub[T]) broadcast(v T) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for _, ch := range h.subs {
+		select {
+		case ch <- v:
+		default:
+		}
+	}
+}
+
+func (h *hub[T]) broadcastErr(err error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for _, ch := range h.errs {
+		select {
+		case ch <- err:
+		default:
+		}
+	}
+}
+
+// dialFunc opens one connection for a feed. On success it returns a next
+// function that blocks for the following value, and a close function to
+// tear the connection down; run loops on next until it errors, then dials
+// again following the feed's ReconnectPolicy.
+type dialFunc[T any] func() (next func() (T, error), closeConn func() error, err error)
+
+// feed owns a single upstream connection shared by every subscriber
+// returned for the same key, reconnecting with backoff when it drops.
+type feed[T any] struct {
+	hub    *hub[T]
+	dial   dialFunc[T]
+	policy ReconnectPolicy
+	cancel context.CancelFunc
+
+	mu       sync.Mutex
+	refcount int
+	dead     bool
+}
+
+func newFeed[T any](dial dialFunc[T], policy ReconnectPolicy) *feed[T] {
+	ctx, cancel := context.WithCancel(context.Background())
+	f := &feed[T]{hub: newHub[T](), dial: dial, policy: policy, cancel: cancel}
+	go f.run(ctx)
+	return f
+}
+
+func (f *feed[T]) run(ctx context.Context) {
+	backoff := f.policy.InitialBackoff
+	for {
+		next, closeConn, err := f.dial()
+		if err != nil {
+			f.hub.broadcastErr(err)
+			if !sleepCtx(ctx, backoff) {
+				return
+			}
+			backoff = nextBackoff(backoff, f.policy)
+			continue
+		}
+		backoff = f.policy.InitialBackoff
+
+		if !f.readLoop(ctx, next, closeConn) {
+			return
+		}
+
+		if !sleepCtx(ctx, backoff) {
+			return
+		}
+		backoff = nextBackoff(backoff, f.policy)
+	}
+}
+
+// readLoop pumps next() into the hub until it errors or ctx is cancelled,
+// so a subscriber calling release (which cancels ctx) doesn't have to wait
+// for a blocked read to return on its own. It reports whether run should
+// dial again; false means ctx was cancelled and the feed should stop.
+func (f *feed[T]) readLoop(ctx context.Context, next func() (T, error), closeConn func() error) bool {
+	type result struct {
+		v   T
+		err error
+	}
+
+	for {
+		results := make(chan result, 1)
+		go func() {
+			v, err := next()
+			results <- result{v, err}
+		}()
+
+		select {
+		case r := <-results:
+			if r.err != nil {
+				f.hub.broadcastErr(r.err)
+				closeConn()
+				return true
+			}
+			f.hub.broadcast(r.v)
+		case <-ctx.Done():
+			closeConn()
+			return false
+		}
+	}
+}
+
+// tryAcquire registers a new subscriber, unless the feed has already shut
+// down (its last subscriber released it and its connection was torn down).
+// Callers share a feed across Subscribe calls via a map keyed by the
+// subscription's parameters (e.g. symbol and timeframe for candles); use acquireFeed so the lookup-or-create and the
+// acquire happen under the same lock, otherwise a feed could be handed out
+// after it went dead but before it was evicted from the map.
+func (f *feed[T]) tryAcquire() (id int, data chan T, errs chan error, ok bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.dead {
+		return 0, nil, nil, false
+	}
+	f.refcount++
+	id, data, errs = f.hub.subscribe()
+	return id, data, errs, true
+}
+
+// release drops a subscriber; once the last one leaves, the feed's
+// connection is torn down and it is marked dead so acquireFeed evicts it.
+func (f *feed[T]) release(id int) {
+	f.hub.unsubscribe(id)
+	f.mu.Lock()
+	f.refcount--
+	done := f.refcount <= 0
+	if done {
+		f.dead = true
+	}
+	f.mu.Unlock()
+	if done {
+		f.cancel()
+	}
+}
+
+// acquireFeed looks up the feed for key in feeds, creating one with create
+// if absent, and acquires a subscriber on it. The caller must hold the lock
+// guarding feeds for the duration of the call.
+func acquireFeed[K comparable, T any](feeds map[K]*feed[T], key K, create func() *feed[T]) (f *feed[T], id int, data chan T, errs chan error) {
+	for {
+		var ok bool
+		f, ok = feeds[key]
+		if !ok {
+			f = create()
+			feeds[key] = f
+		}
+
+		var acquired bool
+		id, data, errs, acquired = f.tryAcquire()
+		if acquired {
+			return f, id, data, errs
+		}
+		delete(feeds, key)
+	}
+}
+
+// genericStream is the subscriber-facing handle returned for one feed
+// registration. CandleStream, TickStream and OrderBookStream are all
+// instantiations of this same type.
+type genericStream[T any] struct {
+	f    *feed[T]
+	id   int
+	data chan T
+	errs chan error
+}
+
+func (s *genericStream[T]) C() <-chan T       { return s.data }
+func (s *genericStream[T]) Err() <-chan error { return s.errs }
+func (s *genericStream[T]) Close() error {
+	s.f.release(s.id)
+	return nil
+}
+
+// CandleStream streams candles for a subscribed asset/timeframe until
+// Close is called.
+type CandleStream = genericStream[Candle]
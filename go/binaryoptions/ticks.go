@@ -0,0 +1,31 @@
+package binaryoptions
+
+import "time"
+
+// Tick is a single trade price update for an asset.
+type Tick struct {
+	Symbol string
+	Time   time.Time
+	Price  float64
+}
+
+// TickStream streams ticks for a subscribed asset until Close is called.
+type TickStream = genericStream[Tick]
+
+// OrderBookLevel is one price/size level of an order book side.
+type OrderBookLevel struct {
+	Price float64
+	Size  float64
+}
+
+// OrderBook is a depth snapshot for an asset.
+type OrderBook struct {
+	Symbol string
+	Time   time.Time
+	Bids   []OrderBookLevel
+	Asks   []OrderBookLevel
+}
+
+// OrderBookStream streams order book snapshots for a subscribed asset until
+// Close is called.
+type OrderBookStream = genericStream[OrderBook]
@@ -0,0 +1,77 @@
+package binaryoptions
+
+import "github.com/shopspring/decimal"
+
+// vbalance tracks a paper account's cash and position using
+// decimal.Decimal, so repeated fills never accumulate floating point drift.
+type vbalance struct {
+	total     decimal.Decimal
+	position  decimal.Decimal
+	feeTotal  decimal.Decimal
+	longCost  decimal.Decimal
+	shortCost decimal.Decimal
+}
+
+func newVBalance(initial float64) *vbalance {
+	return &vbalance{total: decimal.NewFromFloat(initial)}
+}
+
+// openLong records a stake opened on the long ("call") side at openPx.
+func (b *vbalance) openLong(amount, fee, openPx float64) {
+	a := decimal.NewFromFloat(amount)
+	b.total = b.total.Sub(a).Sub(decimal.NewFromFloat(fee))
+	b.feeTotal = b.feeTotal.Add(decimal.NewFromFloat(fee))
+	b.position = b.position.Add(a)
+	b.longCost = b.longCost.Add(a.Mul(decimal.NewFromFloat(openPx)))
+}
+
+// openShort records a stake opened on the short ("put") side at openPx.
+func (b *vbalance) openShort(amount, fee, openPx float64) {
+	a := decimal.NewFromFloat(amount)
+	b.total = b.total.Sub(a).Sub(decimal.NewFromFloat(fee))
+	b.feeTotal = b.feeTotal.Add(decimal.NewFromFloat(fee))
+	b.position = b.position.Sub(a)
+	b.shortCost = b.shortCost.Add(a.Mul(decimal.NewFromFloat(openPx)))
+}
+
+// settle credits the proceeds of a settled deal back to total and unwinds
+// the stake it had contributed to position/longCost/shortCost, so Pos and
+// AvgOpenPrice reflect only still-open deals.
+func (b *vbalance) settle(proceeds float64, dir direction, amount, openPx float64) {
+	a := decimal.NewFromFloat(amount)
+	cost := a.Mul(decimal.NewFromFloat(openPx))
+	if dir == directionLong {
+		b.position = b.position.Sub(a)
+		b.longCost = b.longCost.Sub(cost)
+	} else {
+		b.position = b.position.Add(a)
+		b.shortCost = b.shortCost.Sub(cost)
+	}
+	b.total = b.total.Add(decimal.NewFromFloat(proceeds))
+}
+
+// Get returns the current cash balance.
+func (b *vbalance) Get() float64 {
+	return b.total.InexactFloat64()
+}
+
+// Pos returns the current net open position (positive long, negative short).
+func (b *vbalance) Pos() float64 {
+	return b.position.InexactFloat64()
+}
+
+// AvgOpenPrice returns the size-weighted average open price across the
+// current long and short positions combined.
+func (b *vbalance) AvgOpenPrice() float64 {
+	cost := b.longCost.Add(b.shortCost)
+	size := b.position.Abs()
+	if size.IsZero() {
+		return 0
+	}
+	return cost.Div(size).InexactFloat64()
+}
+
+// GetFeeTotal returns the cumulative fees paid so far.
+func (b *vbalance) GetFeeTotal() float64 {
+	return b.feeTotal.InexactFloat64()
+}
@@ -0,0 +1,51 @@
+package binaryoptions
+
+import "testing"
+
+func TestVBalanceOpenLongAndSettleWin(t *testing.T) {
+	b := newVBalance(1000)
+	b.openLong(100, 1, 50)
+
+	if got, want := b.Get(), 1000.0-100-1; got != want {
+		t.Fatalf("Get() = %v, want %v", got, want)
+	}
+	if got, want := b.Pos(), 100.0; got != want {
+		t.Fatalf("Pos() = %v, want %v", got, want)
+	}
+	if got, want := b.AvgOpenPrice(), 50.0; got != want {
+		t.Fatalf("AvgOpenPrice() = %v, want %v", got, want)
+	}
+	if got, want := b.GetFeeTotal(), 1.0; got != want {
+		t.Fatalf("GetFeeTotal() = %v, want %v", got, want)
+	}
+
+	b.settle(100+85, directionLong, 100, 50) // stake returned plus an 85% payout
+	if got, want := b.Get(), 1000.0-100-1+100+85; got != want {
+		t.Fatalf("Get() after settle = %v, want %v", got, want)
+	}
+	if got := b.Pos(); got != 0 {
+		t.Fatalf("Pos() after settle = %v, want 0", got)
+	}
+	if got := b.AvgOpenPrice(); got != 0 {
+		t.Fatalf("AvgOpenPrice() after settle = %v, want 0", got)
+	}
+}
+
+func TestVBalanceOpenShort(t *testing.T) {
+	b := newVBalance(500)
+	b.openShort(50, 0, 20)
+
+	if got, want := b.Pos(), -50.0; got != want {
+		t.Fatalf("Pos() = %v, want %v", got, want)
+	}
+	if got, want := b.AvgOpenPrice(), 20.0; got != want {
+		t.Fatalf("AvgOpenPrice() = %v, want %v", got, want)
+	}
+}
+
+func TestVBalanceAvgOpenPriceWithNoPosition(t *testing.T) {
+	b := newVBalance(100)
+	if got := b.AvgOpenPrice(); got != 0 {
+		t.Fatalf("AvgOpenPrice() with no position = %v, want 0", got)
+	}
+}
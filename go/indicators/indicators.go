@@ -0,0 +1,146 @@
+// Package indicators implements common technical indicators over a price
+// series (typically candle closes). Every function returns a series
+// aligned to its input, with math.NaN for indices that don't yet have
+// enough history to compute a value.
+package indicators
+
+import "math"
+
+// SMA returns the simple moving average over period points.
+func SMA(prices []float64, period int) []float64 {
+	out := make([]float64, len(prices))
+	var sum float64
+	for i, p := range prices {
+		sum += p
+		if i >= period {
+			sum -= prices[i-period]
+		}
+		if i < period-1 {
+			out[i] = math.NaN()
+			continue
+		}
+		out[i] = sum / float64(period)
+	}
+	return out
+}
+
+// EMA returns the exponential moving average over period points, seeded
+// with an SMA over the first period valid (non-NaN) points.
+func EMA(prices []float64, period int) []float64 {
+	out := make([]float64, len(prices))
+	for i := range out {
+		out[i] = math.NaN()
+	}
+
+	start := 0
+	for start < len(prices) && math.IsNaN(prices[start]) {
+		start++
+	}
+	if len(prices)-start < period {
+		return out
+	}
+
+	var sum float64
+	for i := start; i < start+period; i++ {
+		sum += prices[i]
+	}
+	prev := sum / float64(period)
+	out[start+period-1] = prev
+
+	k := 2 / float64(period+1)
+	for i := start + period; i < len(prices); i++ {
+		prev = prices[i]*k + prev*(1-k)
+		out[i] = prev
+	}
+	return out
+}
+
+// RSI returns the relative strength index over period points.
+func RSI(prices []float64, period int) []float64 {
+	out := make([]float64, len(prices))
+	for i := range out {
+		out[i] = math.NaN()
+	}
+	if len(prices) <= period {
+		return out
+	}
+
+	var gainSum, lossSum float64
+	for i := 1; i <= period; i++ {
+		diff := prices[i] - prices[i-1]
+		if diff > 0 {
+			gainSum += diff
+		} else {
+			lossSum -= diff
+		}
+	}
+	avgGain := gainSum / float64(period)
+	avgLoss := lossSum / float64(period)
+	out[period] = rsiFromAvg(avgGain, avgLoss)
+
+	for i := period + 1; i < len(prices); i++ {
+		diff := prices[i] - prices[i-1]
+		gain, loss := 0.0, 0.0
+		if diff > 0 {
+			gain = diff
+		} else {
+			loss = -diff
+		}
+		avgGain = (avgGain*float64(period-1) + gain) / float64(period)
+		avgLoss = (avgLoss*float64(period-1) + loss) / float64(period)
+		out[i] = rsiFromAvg(avgGain, avgLoss)
+	}
+	return out
+}
+
+func rsiFromAvg(avgGain, avgLoss float64) float64 {
+	if avgLoss == 0 {
+		return 100
+	}
+	rs := avgGain / avgLoss
+	return 100 - (100 / (1 + rs))
+}
+
+// BollingerBands returns the upper, middle (SMA) and lower bands over
+// period points at numStdDev standard deviations.
+func BollingerBands(prices []float64, period int, numStdDev float64) (upper, middle, lower []float64) {
+	middle = SMA(prices, period)
+	upper = make([]float64, len(prices))
+	lower = make([]float64, len(prices))
+	for i := range prices {
+		if i < period-1 {
+			upper[i] = math.NaN()
+			lower[i] = math.NaN()
+			continue
+		}
+		var sumSq float64
+		for j := i - period + 1; j <= i; j++ {
+			d := prices[j] - middle[i]
+			sumSq += d * d
+		}
+		stddev := math.Sqrt(sumSq / float64(period))
+		upper[i] = middle[i] + numStdDev*stddev
+		lower[i] = middle[i] - numStdDev*stddev
+	}
+	return upper, middle, lower
+}
+
+// MACD returns the MACD line, its signal line, and their difference
+// (histogram) using the standard fast/slow/signal EMA periods.
+func MACD(prices []float64, fast, slow, signal int) (macd, signalLine, histogram []float64) {
+	fastEMA := EMA(prices, fast)
+	slowEMA := EMA(prices, slow)
+
+	macd = make([]float64, len(prices))
+	for i := range prices {
+		macd[i] = fastEMA[i] - slowEMA[i]
+	}
+
+	signalLine = EMA(macd, signal)
+
+	histogram = make([]float64, len(prices))
+	for i := range prices {
+		histogram[i] = macd[i] - signalLine[i]
+	}
+	return macd, signalLine, histogram
+}
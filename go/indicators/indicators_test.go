@@ -0,0 +1,107 @@
+package indicators
+
+import (
+	"math"
+	"testing"
+)
+
+func TestSMA(t *testing.T) {
+	prices := []float64{1, 2, 3, 4, 5}
+	got := SMA(prices, 3)
+	want := []float64{math.NaN(), math.NaN(), 2, 3, 4}
+	for i := range want {
+		if math.IsNaN(want[i]) {
+			if !math.IsNaN(got[i]) {
+				t.Fatalf("SMA[%d] = %v, want NaN", i, got[i])
+			}
+			continue
+		}
+		if got[i] != want[i] {
+			t.Fatalf("SMA[%d] = %v, want %v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestEMASeedsFromSMAThenTracksTheNewestPrice(t *testing.T) {
+	prices := []float64{1, 2, 3, 4, 5}
+	got := EMA(prices, 3)
+
+	if !math.IsNaN(got[0]) || !math.IsNaN(got[1]) {
+		t.Fatalf("EMA leading values should be NaN, got %v", got[:2])
+	}
+	if got[2] != 2 { // seed = SMA of the first 3 points
+		t.Fatalf("EMA[2] = %v, want 2 (SMA seed)", got[2])
+	}
+
+	k := 2 / float64(3+1)
+	want3 := prices[3]*k + got[2]*(1-k)
+	if got[3] != want3 {
+		t.Fatalf("EMA[3] = %v, want %v", got[3], want3)
+	}
+}
+
+func TestRSIAllGainsIs100(t *testing.T) {
+	prices := []float64{1, 2, 3, 4, 5, 6}
+	got := RSI(prices, 3)
+	if last := got[len(got)-1]; last != 100 {
+		t.Fatalf("RSI = %v, want 100 for an all-gains series", last)
+	}
+}
+
+func TestRSITooShortSeriesIsAllNaN(t *testing.T) {
+	prices := []float64{1, 2, 3}
+	got := RSI(prices, 5)
+	for i, v := range got {
+		if !math.IsNaN(v) {
+			t.Fatalf("RSI[%d] = %v, want NaN for a series shorter than the period", i, v)
+		}
+	}
+}
+
+// TestBollingerBands checks the bands against hand-computed population
+// mean/stddev for each 3-point window of prices, not against the package's
+// own SMA output.
+func TestBollingerBands(t *testing.T) {
+	prices := []float64{1, 2, 3, 4, 5, 6, 7}
+	upper, middle, lower := BollingerBands(prices, 3, 2)
+
+	wantMiddle := []float64{0, 0, 2, 3, 4, 5, 6}
+	wantUpper := []float64{0, 0, 3.632993161855452, 4.632993161855452, 5.632993161855452, 6.632993161855452, 7.632993161855452}
+	wantLower := []float64{0, 0, 0.36700683814454793, 1.367006838144548, 2.367006838144548, 3.367006838144548, 4.3670068381445475}
+
+	for i := 2; i < len(prices); i++ {
+		if middle[i] != wantMiddle[i] {
+			t.Fatalf("middle[%d] = %v, want %v", i, middle[i], wantMiddle[i])
+		}
+		if diff := math.Abs(upper[i] - wantUpper[i]); diff > 1e-9 {
+			t.Fatalf("upper[%d] = %v, want %v", i, upper[i], wantUpper[i])
+		}
+		if diff := math.Abs(lower[i] - wantLower[i]); diff > 1e-9 {
+			t.Fatalf("lower[%d] = %v, want %v", i, lower[i], wantLower[i])
+		}
+	}
+}
+
+// TestMACD checks MACD/signal/histogram against values hand-computed from
+// the standard EMA-of-EMA-difference definition, not by re-deriving them
+// through the package's own EMA function.
+func TestMACD(t *testing.T) {
+	prices := []float64{2, 4, 6, 5, 7, 9, 8, 10}
+	macd, signal, hist := MACD(prices, 2, 4, 2)
+
+	wantMACD := []float64{0, 0, 0, 0.75, 0.9833333333333334, 1.301111111111111, 0.7510370370370367, 0.974079012345678}
+	wantSignal := []float64{0, 0, 0, 0, 0.8666666666666667, 1.1562962962962964, 0.8861234567901233, 0.9447604938271597}
+	wantHist := []float64{0, 0, 0, 0, 0.1166666666666667, 0.14481481481481473, -0.13508641975308655, 0.0293185185185183}
+
+	for i := 4; i < len(prices); i++ {
+		if diff := math.Abs(macd[i] - wantMACD[i]); diff > 1e-9 {
+			t.Fatalf("macd[%d] = %v, want %v", i, macd[i], wantMACD[i])
+		}
+		if diff := math.Abs(signal[i] - wantSignal[i]); diff > 1e-9 {
+			t.Fatalf("signal[%d] = %v, want %v", i, signal[i], wantSignal[i])
+		}
+		if diff := math.Abs(hist[i] - wantHist[i]); diff > 1e-9 {
+			t.Fatalf("hist[%d] = %v, want %v", i, hist[i], wantHist[i])
+		}
+	}
+}